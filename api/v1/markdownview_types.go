@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TypeMarkdownViewAvailable represents the status of the Deployment reconciliation
+	TypeMarkdownViewAvailable = "Available"
+	// TypeMarkdownViewDegraded is True while any child resource is failed, still rolling out, missing or terminating, and False once all of them are current.
+	TypeMarkdownViewDegraded = "Degraded"
+)
+
+// ViewerType selects which program renders the mounted markdown files.
+// +kubebuilder:validation:Enum=mdbook;mkdocs;docsify
+type ViewerType string
+
+const (
+	// ViewerTypeMdbook serves the markdown with `mdbook serve`.
+	ViewerTypeMdbook ViewerType = "mdbook"
+	// ViewerTypeMkdocs serves the markdown with `mkdocs serve`.
+	ViewerTypeMkdocs ViewerType = "mkdocs"
+	// ViewerTypeDocsify serves the markdown with `docsify serve`.
+	ViewerTypeDocsify ViewerType = "docsify"
+)
+
+// MarkdownViewIngress names the Ingress hosts in front of the viewer Service.
+// The controller does not create or manage an Ingress object; the hosts are
+// only used to report Status.URL.
+type MarkdownViewIngress struct {
+	// Hosts are the hostnames at which the viewer is reached. The first entry
+	// is reported back in Status.URL.
+	// +kubebuilder:validation:MinItems=1
+	Hosts []string `json:"hosts"`
+}
+
+// MarkdownViewSpec defines the desired state of MarkdownView
+type MarkdownViewSpec struct {
+	// Markdowns holds the markdown source files, keyed by file name, that are
+	// mounted into the viewer container via a generated ConfigMap.
+	// +kubebuilder:validation:Required
+	Markdowns map[string]string `json:"markdowns"`
+
+	// ViewerImage is the container image used to serve the rendered markdown.
+	// +optional
+	ViewerImage string `json:"viewerImage,omitempty"`
+
+	// ViewerType selects the viewer backend used to render Markdowns.
+	// +optional
+	ViewerType ViewerType `json:"viewerType,omitempty"`
+
+	// Replicas is the desired number of viewer Pods.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=5
+	// +kubebuilder:default=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Ingress, when set, makes Status.URL report its first host instead of
+	// the viewer Service's in-cluster DNS name. No Ingress object is created.
+	// +optional
+	Ingress *MarkdownViewIngress `json:"ingress,omitempty"`
+}
+
+// MarkdownViewStatus defines the observed state of MarkdownView
+type MarkdownViewStatus struct {
+	// Conditions represent the latest available observations of the MarkdownView's state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// URL is where the rendered markdown can be viewed: the viewer Service's
+	// in-cluster DNS name, or the first Ingress host when Spec.Ingress is set.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Replicas is the observed number of viewer Pods from the Deployment.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the observed number of ready viewer Pods from the Deployment.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// ObservedGeneration is the generation most recently acted on, set only
+	// after every child resource has been applied successfully.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastReconcileTime is when the controller last finished reconciling this MarkdownView.
+	// +optional
+	LastReconcileTime metav1.Time `json:"lastReconcileTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Available",type=string,JSONPath=`.status.conditions[?(@.type=="Available")].status`
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.spec.replicas`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// MarkdownView is the Schema for the markdownviews API
+type MarkdownView struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MarkdownViewSpec   `json:"spec,omitempty"`
+	Status MarkdownViewStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MarkdownViewList contains a list of MarkdownView
+type MarkdownViewList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MarkdownView `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MarkdownView{}, &MarkdownViewList{})
+}
@@ -18,6 +18,8 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -33,17 +35,28 @@ import (
 	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	viewv1 "github.com/Kaniikura/markdown-view/api/v1"
+	"github.com/Kaniikura/markdown-view/internal/controller/readiness"
+	"github.com/Kaniikura/markdown-view/pkg/viewer"
 )
 
 // MarkdownViewReconciler reconciles a MarkdownView object
 type MarkdownViewReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// APIReader bypasses the manager's cache for the typed Get/List calls
+	// this reconciler needs full spec/status on (ConfigMap, Deployment,
+	// Service, Pod), so the metadata-only Owns() watches configured in
+	// SetupWithManager aren't defeated by those reads lazily starting full
+	// typed informers for the same kinds. Callers should set this to
+	// mgr.GetAPIReader().
+	APIReader client.Reader
 }
 
 // +kubebuilder:rbac:groups=view.kaniikura.github.io,resources=markdownviews,verbs=get;list;watch;create;update;patch;delete
@@ -52,6 +65,7 @@ type MarkdownViewReconciler struct {
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;update;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -76,56 +90,105 @@ func (r *MarkdownViewReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
-	if !mdView.ObjectMeta.DeletionTimestamp.IsZero() {
-		return ctrl.Result{}, nil
-	}
-
 	err = r.reconcileConfigMap(ctx, mdView)
 	if err != nil {
-		result, err2 := r.updateStatus(ctx, mdView)
+		result, err2 := r.updateStatus(ctx, mdView, false)
 		logger.Error(err2, "unable to update status")
 		return result, err
 	}
 	err = r.reconcileDeployment(ctx, mdView)
 	if err != nil {
-		result, err2 := r.updateStatus(ctx, mdView)
+		result, err2 := r.updateStatus(ctx, mdView, false)
 		logger.Error(err2, "unable to update status")
 		return result, err
 	}
 	err = r.reconcileService(ctx, mdView)
 	if err != nil {
-		result, err2 := r.updateStatus(ctx, mdView)
+		result, err2 := r.updateStatus(ctx, mdView, false)
 		logger.Error(err2, "unable to update status")
 		return result, err
 	}
 
-	return r.updateStatus(ctx, mdView)
+	return r.updateStatus(ctx, mdView, true)
+}
+
+// viewerLabels returns the labels shared by the viewer Deployment, its Pod
+// template, and the Service that fronts them.
+func viewerLabels(mdView *viewv1.MarkdownView) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "markdown-view",
+		"app.kubernetes.io/instance":   mdView.Name,
+		"app.kubernetes.io/created-by": "markdown-view-controller",
+	}
+}
+
+// ownerReference builds the apply-configuration form of a controller owner
+// reference pointing at mdView, so server-side-apply objects are garbage
+// collected the same way as objects created through the typed client.
+func ownerReference(mdView *viewv1.MarkdownView) *metav1apply.OwnerReferenceApplyConfiguration {
+	return metav1apply.OwnerReference().
+		WithAPIVersion(viewv1.GroupVersion.String()).
+		WithKind("MarkdownView").
+		WithName(mdView.Name).
+		WithUID(mdView.UID).
+		WithController(true).
+		WithBlockOwnerDeletion(true)
 }
 
+// reconcileConfigMap creates or updates the ConfigMap holding mdView's
+// Markdowns. It reimplements the Get+mutate+Create/Update steps that
+// ctrl.CreateOrUpdate would otherwise provide, because that helper ties its
+// Get to the same client.Client used for the Create/Update, and the Get half
+// must instead go through r.APIReader so it doesn't lazily start a full
+// ConfigMap informer (see the comment on SetupWithManager).
 func (r *MarkdownViewReconciler) reconcileConfigMap(ctx context.Context, mdView viewv1.MarkdownView) error {
 	logger := log.FromContext(ctx)
 
+	cmName := "markdowns-" + mdView.Name
 	cm := &corev1.ConfigMap{}
-	cm.SetNamespace(mdView.Namespace)
-	cm.SetName("markdowns-" + mdView.Name)
+	getErr := r.APIReader.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: cmName}, cm)
+	if getErr != nil && !errors.IsNotFound(getErr) {
+		logger.Error(getErr, "unable to fetch ConfigMap")
+		return getErr
+	}
+	exists := getErr == nil
 
-	op, err := ctrl.CreateOrUpdate(ctx, r.Client, cm, func() error {
-		if cm.Data == nil {
-			cm.Data = map[string]string{}
-		}
-		for name, content := range mdView.Spec.Markdowns {
-			cm.Data[name] = content
-		}
+	if !exists {
+		cm.SetNamespace(mdView.Namespace)
+		cm.SetName(cmName)
+	}
+
+	before := cm.DeepCopy()
+	cm.SetLabels(viewerLabels(&mdView))
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	for name, content := range mdView.Spec.Markdowns {
+		cm.Data[name] = content
+	}
+	if err := controllerutil.SetControllerReference(&mdView, cm, r.Scheme); err != nil {
+		return err
+	}
+
+	if exists && equality.Semantic.DeepEqual(before, cm) {
 		return nil
-	})
+	}
+
+	var err error
+	op := controllerutil.OperationResultNone
+	if !exists {
+		err = r.Create(ctx, cm)
+		op = controllerutil.OperationResultCreated
+	} else {
+		err = r.Update(ctx, cm)
+		op = controllerutil.OperationResultUpdated
+	}
 
 	if err != nil {
 		logger.Error(err, "unable to create or update ConfigMap")
 		return err
 	}
-	if op != controllerutil.OperationResultNone {
-		logger.Info("reconcile ConfigMap successfully", "op", op)
-	}
+	logger.Info("reconcile ConfigMap successfully", "op", op)
 	return nil
 }
 
@@ -133,58 +196,45 @@ func (r *MarkdownViewReconciler) reconcileDeployment(ctx context.Context, mdView
 	logger := log.FromContext(ctx)
 
 	depName := "viewer-" + mdView.Name
-	viewerImage := mdView.Spec.ViewerImage
+
+	backend, err := viewer.Get(mdView.Spec.ViewerType)
+	if err != nil {
+		logger.Error(err, "unable to resolve viewer backend")
+		return err
+	}
+
+	container := backend.ContainerSpec(&mdView).
+		WithVolumeMounts(backend.VolumeMounts()...).
+		WithPorts(corev1apply.ContainerPort().
+			WithName("http").
+			WithProtocol(corev1.ProtocolTCP).
+			WithContainerPort(backend.Port()),
+		).
+		WithLivenessProbe(corev1apply.Probe().
+			WithHTTPGet(corev1apply.HTTPGetAction().
+				WithPort(intstr.FromString("http")).
+				WithPath(backend.ReadinessPath()).
+				WithScheme(corev1.URISchemeHTTP),
+			),
+		).
+		WithReadinessProbe(corev1apply.Probe().
+			WithHTTPGet(corev1apply.HTTPGetAction().
+				WithPort(intstr.FromString("http")).
+				WithPath(backend.ReadinessPath()).
+				WithScheme(corev1.URISchemeHTTP),
+			),
+		)
 
 	dep := appsv1apply.Deployment(depName, mdView.Namespace).
-		WithLabels(map[string]string{
-			"app.kubernetes.io/name":       "mdbook",
-			"app.kubernetes.io/instance":   mdView.Name,
-			"app.kubernetes.io/created-by": "markdown-view-controller",
-		}).
+		WithLabels(viewerLabels(&mdView)).
+		WithOwnerReferences(ownerReference(&mdView)).
 		WithSpec(appsv1apply.DeploymentSpec().
 			WithReplicas(mdView.Spec.Replicas).
-			WithSelector(metav1apply.LabelSelector().WithMatchLabels(map[string]string{
-				"app.kubernetes.io/name":       "mdbook",
-				"app.kubernetes.io/instance":   mdView.Name,
-				"app.kubernetes.io/created-by": "markdown-view-controller",
-			})).
+			WithSelector(metav1apply.LabelSelector().WithMatchLabels(viewerLabels(&mdView))).
 			WithTemplate(corev1apply.PodTemplateSpec().
-				WithLabels(map[string]string{
-					"app.kubernetes.io/name":       "mdbook",
-					"app.kubernetes.io/instance":   mdView.Name,
-					"app.kubernetes.io/created-by": "markdown-view-controller",
-				}).
+				WithLabels(viewerLabels(&mdView)).
 				WithSpec(corev1apply.PodSpec().
-					WithContainers(corev1apply.Container().
-						WithName("mdbook").
-						WithImage(viewerImage).
-						WithImagePullPolicy(corev1.PullIfNotPresent).
-						WithCommand("mdbook").
-						WithArgs("serve", "--hostname", "0.0.0.0").
-						WithVolumeMounts(corev1apply.VolumeMount().
-							WithName("markdowns").
-							WithMountPath("/book/src"),
-						).
-						WithPorts(corev1apply.ContainerPort().
-							WithName("http").
-							WithProtocol(corev1.ProtocolTCP).
-							WithContainerPort(3000),
-						).
-						WithLivenessProbe(corev1apply.Probe().
-							WithHTTPGet(corev1apply.HTTPGetAction().
-								WithPort(intstr.FromString("http")).
-								WithPath("/").
-								WithScheme(corev1.URISchemeHTTP),
-							),
-						).
-						WithReadinessProbe(corev1apply.Probe().
-							WithHTTPGet(corev1apply.HTTPGetAction().
-								WithPort(intstr.FromString("http")).
-								WithPath("/").
-								WithScheme(corev1.URISchemeHTTP),
-							),
-						),
-					).
+					WithContainers(container).
 					WithVolumes(corev1apply.Volume().
 						WithName("markdowns").
 						WithConfigMap(corev1apply.ConfigMapVolumeSource().
@@ -203,7 +253,7 @@ func (r *MarkdownViewReconciler) reconcileDeployment(ctx context.Context, mdView
 	patch := &unstructured.Unstructured{Object: obj}
 
 	var current appsv1.Deployment
-	err = r.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: depName}, &current)
+	err = r.APIReader.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: depName}, &current)
 	if err != nil && !errors.IsNotFound(err) {
 		return err
 	}
@@ -234,23 +284,22 @@ func (r *MarkdownViewReconciler) reconcileService(ctx context.Context, mdView vi
 	logger := log.FromContext(ctx)
 	svcName := "viewer-" + mdView.Name
 
+	backend, err := viewer.Get(mdView.Spec.ViewerType)
+	if err != nil {
+		logger.Error(err, "unable to resolve viewer backend")
+		return err
+	}
+
 	svc := corev1apply.Service(svcName, mdView.Namespace).
-		WithLabels(map[string]string{
-			"app.kubernetes.io/name":       "mdbook",
-			"app.kubernetes.io/instance":   mdView.Name,
-			"app.kubernetes.io/created-by": "markdown-view-controller",
-		}).
+		WithLabels(viewerLabels(&mdView)).
+		WithOwnerReferences(ownerReference(&mdView)).
 		WithSpec(corev1apply.ServiceSpec().
-			WithSelector(map[string]string{
-				"app.kubernetes.io/name":       "mdbook",
-				"app.kubernetes.io/instance":   mdView.Name,
-				"app.kubernetes.io/created-by": "markdown-view-controller",
-			}).
+			WithSelector(viewerLabels(&mdView)).
 			WithType(corev1.ServiceTypeClusterIP).
 			WithPorts(corev1apply.ServicePort().
 				WithProtocol(corev1.ProtocolTCP).
 				WithPort(80).
-				WithTargetPort(intstr.FromInt(3000)),
+				WithTargetPort(intstr.FromInt32(backend.Port())),
 			),
 		)
 
@@ -263,7 +312,7 @@ func (r *MarkdownViewReconciler) reconcileService(ctx context.Context, mdView vi
 	}
 
 	var current corev1.Service
-	err = r.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: svcName}, &current)
+	err = r.APIReader.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: svcName}, &current)
 	if err != nil && !errors.IsNotFound(err) {
 		return err
 	}
@@ -290,81 +339,161 @@ func (r *MarkdownViewReconciler) reconcileService(ctx context.Context, mdView vi
 	return nil
 }
 
-func (r *MarkdownViewReconciler) updateStatus(ctx context.Context, mdView viewv1.MarkdownView) (ctrl.Result, error) {
-	meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-		Type:   viewv1.TypeMarkdownViewAvailable,
-		Status: metav1.ConditionTrue,
-		Reason: "OK",
-	})
-	meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-		Type:   viewv1.TypeMarkdownViewDegraded,
-		Status: metav1.ConditionFalse,
-		Reason: "OK",
-	})
+// readinessRank orders readiness.Status values from worst to best so the
+// overall verdict for a MarkdownView can be taken as the worst of its
+// children's verdicts.
+var readinessRank = map[readiness.Status]int{
+	readiness.Failed:      0,
+	readiness.NotFound:    1,
+	readiness.Terminating: 2,
+	readiness.InProgress:  3,
+	readiness.Current:     4,
+}
 
+// evaluateReadiness turns a Get error plus the fetched object into a
+// readiness verdict, treating "not found" as its own NotFound status rather
+// than a reconcile error.
+func evaluateReadiness(obj client.Object, getErr error) (readiness.Status, string, error) {
+	if errors.IsNotFound(getErr) {
+		return readiness.NotFound, "NotFound", nil
+	}
+	if getErr != nil {
+		return "", "", getErr
+	}
+	return readiness.Compute(obj)
+}
+
+// imagePullBackOffReason inspects the viewer Pods for a MarkdownView and
+// returns the waiting reason/message when a container is stuck pulling its
+// image, so a stalled rollout can be reported as Failed instead of
+// InProgress forever.
+func (r *MarkdownViewReconciler) imagePullBackOffReason(ctx context.Context, mdView viewv1.MarkdownView) (reason, message string) {
+	var pods corev1.PodList
+	if err := r.APIReader.List(ctx, &pods, client.InNamespace(mdView.Namespace), client.MatchingLabels(viewerLabels(&mdView))); err != nil {
+		return "", ""
+	}
+
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting == nil {
+				continue
+			}
+			switch cs.State.Waiting.Reason {
+			case "ImagePullBackOff", "ErrImagePull":
+				return cs.State.Waiting.Reason, cs.State.Waiting.Message
+			}
+		}
+	}
+	return "", ""
+}
+
+// viewerURL reports where the rendered markdown can be viewed: the first
+// Ingress host when Spec.Ingress is set, otherwise the viewer Service's
+// in-cluster DNS name.
+func viewerURL(mdView *viewv1.MarkdownView) string {
+	if mdView.Spec.Ingress != nil && len(mdView.Spec.Ingress.Hosts) > 0 {
+		return "http://" + mdView.Spec.Ingress.Hosts[0]
+	}
+	return fmt.Sprintf("http://viewer-%s.%s.svc.cluster.local", mdView.Name, mdView.Namespace)
+}
+
+// updateStatus refreshes the Available/Degraded conditions and the
+// observability fields (URL, replica counts, ObservedGeneration) from the
+// latest state of the child resources. synced must only be true once every
+// child resource has been applied successfully for mdView.Generation, since
+// that's what ObservedGeneration promises to callers.
+func (r *MarkdownViewReconciler) updateStatus(ctx context.Context, mdView viewv1.MarkdownView, synced bool) (ctrl.Result, error) {
 	var cm corev1.ConfigMap
-	err := r.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: "markdowns-" + mdView.Name}, &cm)
-	if errors.IsNotFound(err) {
-		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-			Type:    viewv1.TypeMarkdownViewDegraded,
-			Status:  metav1.ConditionTrue,
-			Reason:  "Reconciling",
-			Message: "ConfigMap not found",
-		})
-		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-			Type:   viewv1.TypeMarkdownViewAvailable,
-			Status: metav1.ConditionFalse,
-			Reason: "Reconciling",
-		})
-	} else if err != nil {
+	cmErr := r.APIReader.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: "markdowns-" + mdView.Name}, &cm)
+	cmStatus, cmReason, err := evaluateReadiness(&cm, cmErr)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
 
 	var svc corev1.Service
-	err = r.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: "viewer-" + mdView.Name}, &svc)
-	if errors.IsNotFound(err) {
-		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-			Type:    viewv1.TypeMarkdownViewDegraded,
-			Status:  metav1.ConditionTrue,
-			Reason:  "Reconciling",
-			Message: "Service not found",
-		})
-		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-			Type:   viewv1.TypeMarkdownViewAvailable,
-			Status: metav1.ConditionFalse,
-			Reason: "Reconciling",
-		})
-	} else if err != nil {
+	svcErr := r.APIReader.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: "viewer-" + mdView.Name}, &svc)
+	svcStatus, svcReason, err := evaluateReadiness(&svc, svcErr)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
 
 	var dep appsv1.Deployment
-	err = r.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: "viewer-" + mdView.Name}, &dep)
-	if errors.IsNotFound(err) {
-		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-			Type:    viewv1.TypeMarkdownViewDegraded,
-			Status:  metav1.ConditionTrue,
-			Reason:  "Reconciling",
-			Message: "Deployment not found",
-		})
-		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-			Type:   viewv1.TypeMarkdownViewAvailable,
-			Status: metav1.ConditionFalse,
-			Reason: "Reconciling",
-		})
-	} else if err != nil {
+	depErr := r.APIReader.Get(ctx, client.ObjectKey{Namespace: mdView.Namespace, Name: "viewer-" + mdView.Name}, &dep)
+	depStatus, depReason, err := evaluateReadiness(&dep, depErr)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
+	depMessage := ""
+	if depStatus == readiness.InProgress {
+		if reason, message := r.imagePullBackOffReason(ctx, mdView); reason != "" {
+			depStatus, depReason, depMessage = readiness.Failed, reason, message
+		}
+	}
+
+	worst, worstReason, worstMessage := cmStatus, cmReason, ""
+	for _, candidate := range []struct {
+		status  readiness.Status
+		reason  string
+		message string
+	}{{svcStatus, svcReason, ""}, {depStatus, depReason, depMessage}} {
+		if readinessRank[candidate.status] < readinessRank[worst] {
+			worst, worstReason, worstMessage = candidate.status, candidate.reason, candidate.message
+		}
+	}
 
 	result := ctrl.Result{}
-	if dep.Status.AvailableReplicas == 0 {
+	switch worst {
+	case readiness.Current:
+		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
+			Type:               viewv1.TypeMarkdownViewAvailable,
+			Status:             metav1.ConditionTrue,
+			Reason:             "OK",
+			ObservedGeneration: mdView.Generation,
+		})
 		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
-			Type:    viewv1.TypeMarkdownViewAvailable,
-			Status:  metav1.ConditionFalse,
-			Reason:  "Unavailable",
-			Message: "AvailableReplicas is 0",
+			Type:               viewv1.TypeMarkdownViewDegraded,
+			Status:             metav1.ConditionFalse,
+			Reason:             "OK",
+			ObservedGeneration: mdView.Generation,
 		})
-		result = ctrl.Result{Requeue: true}
+	case readiness.Failed:
+		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
+			Type:               viewv1.TypeMarkdownViewAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             worstReason,
+			Message:            worstMessage,
+			ObservedGeneration: mdView.Generation,
+		})
+		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
+			Type:               viewv1.TypeMarkdownViewDegraded,
+			Status:             metav1.ConditionTrue,
+			Reason:             worstReason,
+			Message:            "viewer rollout failed",
+			ObservedGeneration: mdView.Generation,
+		})
+	default: // InProgress, NotFound, Terminating
+		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
+			Type:               viewv1.TypeMarkdownViewAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             worstReason,
+			ObservedGeneration: mdView.Generation,
+		})
+		meta.SetStatusCondition(&mdView.Status.Conditions, metav1.Condition{
+			Type:               viewv1.TypeMarkdownViewDegraded,
+			Status:             metav1.ConditionTrue,
+			Reason:             worstReason,
+			Message:            "Reconciling",
+			ObservedGeneration: mdView.Generation,
+		})
+		result = ctrl.Result{RequeueAfter: 10 * time.Second}
+	}
+
+	mdView.Status.URL = viewerURL(&mdView)
+	mdView.Status.Replicas = dep.Status.Replicas
+	mdView.Status.ReadyReplicas = dep.Status.ReadyReplicas
+	mdView.Status.LastReconcileTime = metav1.Now()
+	if synced {
+		mdView.Status.ObservedGeneration = mdView.Generation
 	}
 
 	err = r.Status().Update(ctx, &mdView)
@@ -372,9 +501,26 @@ func (r *MarkdownViewReconciler) updateStatus(ctx context.Context, mdView viewv1
 }
 
 // SetupWithManager sets up the controller with the Manager.
+//
+// The owned ConfigMap/Deployment/Service kinds are watched through
+// builder.OnlyMetadata: the shared informer backing these watches only needs
+// to know that a child changed so it can enqueue the owning MarkdownView, so
+// it caches metav1.PartialObjectMetadata instead of full typed objects. This
+// matters most for ConfigMaps, whose informer would otherwise be shared with
+// every unrelated ConfigMap in the watched namespaces. readiness.Compute
+// still needs spec/status fields, so updateStatus re-fetches the typed
+// objects it passes in through r.APIReader (an uncached, direct-to-apiserver reader set up
+// in cmd/main.go from mgr.GetAPIReader()) instead of through the metadata-only
+// cache; the manager's cache.Options.ByObject (see cmd/main.go) additionally
+// restricts the typed ConfigMap cache to
+// app.kubernetes.io/created-by=markdown-view-controller for any other code
+// path that does go through the cache.
 func (r *MarkdownViewReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&viewv1.MarkdownView{}).
+		Owns(&corev1.ConfigMap{}, builder.OnlyMetadata).
+		Owns(&appsv1.Deployment{}, builder.OnlyMetadata).
+		Owns(&corev1.Service{}, builder.OnlyMetadata).
 		Named("markdownview").
 		Complete(r)
 }
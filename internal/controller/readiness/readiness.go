@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package readiness computes a kstatus-style readiness verdict for the
+// resources a MarkdownView manages, the way `kubectl rollout status` or
+// Helm's resource-status-check reason about a Deployment rollout instead of
+// looking at a single field.
+package readiness
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Status is the coarse-grained readiness verdict for a single object.
+type Status string
+
+const (
+	// InProgress means the object's controller has accepted the latest spec
+	// but the cluster has not yet converged to it.
+	InProgress Status = "InProgress"
+	// Current means the object matches its desired state.
+	Current Status = "Current"
+	// Failed means the object's controller has given up converging, e.g. a
+	// Deployment rollout that exceeded its progress deadline.
+	Failed Status = "Failed"
+	// Terminating means the object is being deleted.
+	Terminating Status = "Terminating"
+	// NotFound means the object does not exist yet.
+	NotFound Status = "NotFound"
+)
+
+// Compute evaluates the readiness of obj using a per-kind rule, returning the
+// verdict together with a human-readable reason suitable for a Condition's
+// Reason/Message fields.
+func Compute(obj client.Object) (Status, string, error) {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return Terminating, "DeletionTimestampSet", nil
+	}
+
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return computeDeployment(o)
+	case *corev1.Service:
+		return computeService(o)
+	case *corev1.ConfigMap:
+		return Current, "ConfigMapExists", nil
+	default:
+		return "", "", fmt.Errorf("readiness: unsupported kind %T", obj)
+	}
+}
+
+func computeDeployment(dep *appsv1.Deployment) (Status, string, error) {
+	if dep.Generation != dep.Status.ObservedGeneration {
+		return InProgress, "ObservedGenerationOutdated", nil
+	}
+
+	for _, cond := range dep.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing &&
+			cond.Status == corev1.ConditionFalse &&
+			cond.Reason == "ProgressDeadlineExceeded" {
+			return Failed, "ProgressDeadlineExceeded", nil
+		}
+	}
+
+	var desired int32 = 1
+	if dep.Spec.Replicas != nil {
+		desired = *dep.Spec.Replicas
+	}
+
+	switch {
+	case dep.Status.UpdatedReplicas < desired:
+		return InProgress, "DeploymentProgressing", nil
+	case dep.Status.AvailableReplicas < desired:
+		return InProgress, "DeploymentProgressing", nil
+	case dep.Status.ReadyReplicas < desired:
+		return InProgress, "DeploymentProgressing", nil
+	default:
+		return Current, "DeploymentAvailable", nil
+	}
+}
+
+func computeService(svc *corev1.Service) (Status, string, error) {
+	if svc.Spec.Type == corev1.ServiceTypeClusterIP && svc.Spec.ClusterIP == "" {
+		return InProgress, "ClusterIPNotAssigned", nil
+	}
+	return Current, "ServiceReady", nil
+}
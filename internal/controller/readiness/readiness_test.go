@@ -0,0 +1,252 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestComputeDeployment(t *testing.T) {
+	tests := []struct {
+		name       string
+		dep        *appsv1.Deployment
+		wantStatus Status
+		wantReason string
+	}{
+		{
+			name: "observed generation outdated",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			},
+			wantStatus: InProgress,
+			wantReason: "ObservedGenerationOutdated",
+		},
+		{
+			name: "progress deadline exceeded",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Conditions: []appsv1.DeploymentCondition{
+						{
+							Type:   appsv1.DeploymentProgressing,
+							Status: corev1.ConditionFalse,
+							Reason: "ProgressDeadlineExceeded",
+						},
+					},
+				},
+			},
+			wantStatus: Failed,
+			wantReason: "ProgressDeadlineExceeded",
+		},
+		{
+			name: "updated replicas behind desired",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					UpdatedReplicas:    2,
+					AvailableReplicas:  3,
+					ReadyReplicas:      3,
+				},
+			},
+			wantStatus: InProgress,
+			wantReason: "DeploymentProgressing",
+		},
+		{
+			name: "available replicas behind desired",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					UpdatedReplicas:    3,
+					AvailableReplicas:  2,
+					ReadyReplicas:      3,
+				},
+			},
+			wantStatus: InProgress,
+			wantReason: "DeploymentProgressing",
+		},
+		{
+			name: "ready replicas behind desired",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					UpdatedReplicas:    3,
+					AvailableReplicas:  3,
+					ReadyReplicas:      2,
+				},
+			},
+			wantStatus: InProgress,
+			wantReason: "DeploymentProgressing",
+		},
+		{
+			name: "fully available",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					UpdatedReplicas:    3,
+					AvailableReplicas:  3,
+					ReadyReplicas:      3,
+				},
+			},
+			wantStatus: Current,
+			wantReason: "DeploymentAvailable",
+		},
+		{
+			name: "nil replicas defaults desired to 1",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					UpdatedReplicas:    1,
+					AvailableReplicas:  1,
+					ReadyReplicas:      1,
+				},
+			},
+			wantStatus: Current,
+			wantReason: "DeploymentAvailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStatus, gotReason, err := computeDeployment(tt.dep)
+			if err != nil {
+				t.Fatalf("computeDeployment() returned error: %v", err)
+			}
+			if gotStatus != tt.wantStatus {
+				t.Errorf("status = %v, want %v", gotStatus, tt.wantStatus)
+			}
+			if gotReason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", gotReason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestComputeService(t *testing.T) {
+	tests := []struct {
+		name       string
+		svc        *corev1.Service
+		wantStatus Status
+		wantReason string
+	}{
+		{
+			name: "ClusterIP not yet assigned",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+			},
+			wantStatus: InProgress,
+			wantReason: "ClusterIPNotAssigned",
+		},
+		{
+			name: "ClusterIP assigned",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: "10.0.0.1"},
+			},
+			wantStatus: Current,
+			wantReason: "ServiceReady",
+		},
+		{
+			name: "non-ClusterIP type is ready regardless of ClusterIP",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName},
+			},
+			wantStatus: Current,
+			wantReason: "ServiceReady",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStatus, gotReason, err := computeService(tt.svc)
+			if err != nil {
+				t.Fatalf("computeService() returned error: %v", err)
+			}
+			if gotStatus != tt.wantStatus {
+				t.Errorf("status = %v, want %v", gotStatus, tt.wantStatus)
+			}
+			if gotReason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", gotReason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name       string
+		obj        client.Object
+		wantStatus Status
+		wantErr    bool
+	}{
+		{
+			name: "deletion timestamp set takes precedence",
+			obj: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation:        1,
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+					Finalizers:        []string{"kubernetes"},
+				},
+				Status: appsv1.DeploymentStatus{ObservedGeneration: 1},
+			},
+			wantStatus: Terminating,
+		},
+		{
+			name:       "ConfigMap is always Current",
+			obj:        &corev1.ConfigMap{},
+			wantStatus: Current,
+		},
+		{
+			name:    "unsupported kind returns an error",
+			obj:     &corev1.Pod{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStatus, _, err := Compute(tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if gotStatus != tt.wantStatus {
+				t.Errorf("status = %v, want %v", gotStatus, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	viewv1 "github.com/Kaniikura/markdown-view/api/v1"
+	"github.com/Kaniikura/markdown-view/pkg/viewer"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -71,8 +72,14 @@ func (d *MarkdownViewCustomDefaulter) Default(ctx context.Context, obj runtime.O
 	}
 	markdownviewlog.Info("Defaulting for MarkdownView", "name", markdownview.GetName())
 
+	if len(markdownview.Spec.ViewerType) == 0 {
+		markdownview.Spec.ViewerType = viewv1.ViewerTypeMdbook
+	}
+
 	if len(markdownview.Spec.ViewerImage) == 0 {
-		markdownview.Spec.ViewerImage = "peaceiris/mdbook:latest"
+		if backend, err := viewer.Get(markdownview.Spec.ViewerType); err == nil {
+			markdownview.Spec.ViewerImage = backend.DefaultImage()
+		}
 	}
 
 	return nil
@@ -98,8 +105,11 @@ func (v *MarkdownViewCustomValidator) validate(obj *viewv1.MarkdownView) (admiss
 		errs = append(errs, field.Invalid(field.NewPath("spec", "replicas"), obj.Spec.Replicas, "replicas must be in the range of 1 to 5."))
 	}
 
-	if _, ok := obj.Spec.Markdowns["SUMMARY.md"]; !ok {
-		errs = append(errs, field.Required(field.NewPath("spec", "markdowns"), "markdowns must have SUMMARY.md."))
+	requiredFile, err := viewer.RequiredFile(obj.Spec.ViewerType)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "viewerType"), obj.Spec.ViewerType, err.Error()))
+	} else if _, ok := obj.Spec.Markdowns[requiredFile]; !ok {
+		errs = append(errs, field.Required(field.NewPath("spec", "markdowns"), "markdowns must have "+requiredFile+"."))
 	}
 
 	if len(errs) > 0 {
@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package viewer abstracts the markdown-rendering program run inside a
+// MarkdownView's Deployment, so reconcileDeployment/reconcileService don't
+// need to hard-code mdbook's command, port and mount path.
+package viewer
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	corev1apply "k8s.io/client-go/applyconfigurations/core/v1"
+
+	viewv1 "github.com/Kaniikura/markdown-view/api/v1"
+)
+
+// Backend abstracts a program capable of rendering the Markdowns ConfigMap.
+type Backend interface {
+	// ContainerSpec returns the viewer container's name, image, command and
+	// args for mdView. The caller adds the container port, probes and volume
+	// mounts from Port, ReadinessPath and VolumeMounts.
+	ContainerSpec(mdView *viewv1.MarkdownView) *corev1apply.ContainerApplyConfiguration
+	// VolumeMounts returns the mounts the viewer container expects for the
+	// "markdowns" ConfigMap volume.
+	VolumeMounts() []*corev1apply.VolumeMountApplyConfiguration
+	// Port is the container port the viewer listens on.
+	Port() int32
+	// ReadinessPath is the HTTP path used for the liveness/readiness probes.
+	ReadinessPath() string
+	// DefaultImage is the image used when Spec.ViewerImage is unset.
+	DefaultImage() string
+}
+
+// Get returns the Backend for viewerType, defaulting to mdbook when empty.
+func Get(viewerType viewv1.ViewerType) (Backend, error) {
+	switch viewerType {
+	case "", viewv1.ViewerTypeMdbook:
+		return mdbookBackend{}, nil
+	case viewv1.ViewerTypeMkdocs:
+		return mkdocsBackend{}, nil
+	case viewv1.ViewerTypeDocsify:
+		return docsifyBackend{}, nil
+	default:
+		return nil, fmt.Errorf("viewer: unknown viewer type %q", viewerType)
+	}
+}
+
+// RequiredFile returns the markdown source file a backend needs to boot
+// (e.g. mdbook's SUMMARY.md), used by the validating webhook.
+func RequiredFile(viewerType viewv1.ViewerType) (string, error) {
+	switch viewerType {
+	case "", viewv1.ViewerTypeMdbook:
+		return "SUMMARY.md", nil
+	case viewv1.ViewerTypeMkdocs:
+		return "mkdocs.yml", nil
+	case viewv1.ViewerTypeDocsify:
+		return "index.html", nil
+	default:
+		return "", fmt.Errorf("viewer: unknown viewer type %q", viewerType)
+	}
+}
+
+type mdbookBackend struct{}
+
+func (mdbookBackend) ContainerSpec(mdView *viewv1.MarkdownView) *corev1apply.ContainerApplyConfiguration {
+	return corev1apply.Container().
+		WithName("mdbook").
+		WithImage(mdView.Spec.ViewerImage).
+		WithImagePullPolicy(corev1.PullIfNotPresent).
+		WithCommand("mdbook").
+		WithArgs("serve", "--hostname", "0.0.0.0")
+}
+
+func (mdbookBackend) VolumeMounts() []*corev1apply.VolumeMountApplyConfiguration {
+	return []*corev1apply.VolumeMountApplyConfiguration{
+		corev1apply.VolumeMount().WithName("markdowns").WithMountPath("/book/src"),
+	}
+}
+
+func (mdbookBackend) Port() int32           { return 3000 }
+func (mdbookBackend) ReadinessPath() string { return "/" }
+func (mdbookBackend) DefaultImage() string  { return "peaceiris/mdbook:latest" }
+
+type mkdocsBackend struct{}
+
+func (mkdocsBackend) ContainerSpec(mdView *viewv1.MarkdownView) *corev1apply.ContainerApplyConfiguration {
+	return corev1apply.Container().
+		WithName("mkdocs").
+		WithImage(mdView.Spec.ViewerImage).
+		WithImagePullPolicy(corev1.PullIfNotPresent).
+		WithCommand("mkdocs").
+		WithArgs("serve", "-a", "0.0.0.0:8000")
+}
+
+func (mkdocsBackend) VolumeMounts() []*corev1apply.VolumeMountApplyConfiguration {
+	return []*corev1apply.VolumeMountApplyConfiguration{
+		corev1apply.VolumeMount().WithName("markdowns").WithMountPath("/docs"),
+	}
+}
+
+func (mkdocsBackend) Port() int32           { return 8000 }
+func (mkdocsBackend) ReadinessPath() string { return "/" }
+func (mkdocsBackend) DefaultImage() string  { return "squidfunk/mkdocs-material:latest" }
+
+type docsifyBackend struct{}
+
+func (docsifyBackend) ContainerSpec(mdView *viewv1.MarkdownView) *corev1apply.ContainerApplyConfiguration {
+	return corev1apply.Container().
+		WithName("docsify").
+		WithImage(mdView.Spec.ViewerImage).
+		WithImagePullPolicy(corev1.PullIfNotPresent).
+		WithCommand("docsify").
+		WithArgs("serve", ".")
+}
+
+func (docsifyBackend) VolumeMounts() []*corev1apply.VolumeMountApplyConfiguration {
+	return []*corev1apply.VolumeMountApplyConfiguration{
+		corev1apply.VolumeMount().WithName("markdowns").WithMountPath("/markdowns"),
+	}
+}
+
+func (docsifyBackend) Port() int32           { return 3000 }
+func (docsifyBackend) ReadinessPath() string { return "/" }
+func (docsifyBackend) DefaultImage() string  { return "docsifyjs/docsify:latest" }
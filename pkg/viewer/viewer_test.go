@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package viewer
+
+import (
+	"testing"
+
+	viewv1 "github.com/Kaniikura/markdown-view/api/v1"
+)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name       string
+		viewerType viewv1.ViewerType
+		wantPort   int32
+		wantErr    bool
+	}{
+		{name: "empty defaults to mdbook", viewerType: "", wantPort: 3000},
+		{name: "mdbook", viewerType: viewv1.ViewerTypeMdbook, wantPort: 3000},
+		{name: "mkdocs", viewerType: viewv1.ViewerTypeMkdocs, wantPort: 8000},
+		{name: "docsify", viewerType: viewv1.ViewerTypeDocsify, wantPort: 3000},
+		{name: "unknown", viewerType: viewv1.ViewerType("unknown"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend, err := Get(tt.viewerType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := backend.Port(); got != tt.wantPort {
+				t.Errorf("Port() = %d, want %d", got, tt.wantPort)
+			}
+			if got := backend.DefaultImage(); got == "" {
+				t.Errorf("DefaultImage() returned empty string")
+			}
+			if got := backend.ReadinessPath(); got == "" {
+				t.Errorf("ReadinessPath() returned empty string")
+			}
+		})
+	}
+}
+
+func TestRequiredFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		viewerType viewv1.ViewerType
+		want       string
+		wantErr    bool
+	}{
+		{name: "empty defaults to mdbook", viewerType: "", want: "SUMMARY.md"},
+		{name: "mdbook", viewerType: viewv1.ViewerTypeMdbook, want: "SUMMARY.md"},
+		{name: "mkdocs", viewerType: viewv1.ViewerTypeMkdocs, want: "mkdocs.yml"},
+		{name: "docsify", viewerType: viewv1.ViewerTypeDocsify, want: "index.html"},
+		{name: "unknown", viewerType: viewv1.ViewerType("unknown"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RequiredFile(tt.viewerType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RequiredFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RequiredFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainerSpecUsesViewerImage(t *testing.T) {
+	mdView := &viewv1.MarkdownView{
+		Spec: viewv1.MarkdownViewSpec{ViewerImage: "example.com/custom:latest"},
+	}
+
+	for _, viewerType := range []viewv1.ViewerType{viewv1.ViewerTypeMdbook, viewv1.ViewerTypeMkdocs, viewv1.ViewerTypeDocsify} {
+		t.Run(string(viewerType), func(t *testing.T) {
+			backend, err := Get(viewerType)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			container := backend.ContainerSpec(mdView)
+			if container.Image == nil || *container.Image != mdView.Spec.ViewerImage {
+				t.Errorf("ContainerSpec().Image = %v, want %q", container.Image, mdView.Spec.ViewerImage)
+			}
+			if len(backend.VolumeMounts()) == 0 {
+				t.Errorf("VolumeMounts() returned no mounts")
+			}
+		})
+	}
+}